@@ -0,0 +1,13 @@
+package container
+
+// BindType identifies how a binding's concrete is produced and cached.
+type BindType int8
+
+const (
+	// transientType resolvers run on every resolve; nothing is cached.
+	transientType BindType = iota
+	// singletonType resolvers run once, eagerly, at bind time.
+	singletonType
+	// delaySingletonType resolvers run once, lazily, on first resolve.
+	delaySingletonType
+)
@@ -13,37 +13,74 @@ import (
 // binding holds a resolver and a concrete (if singleton).
 // It is the break for the Container wall!
 type binding struct {
-	bindType BindType
-	resolver interface{} // resolver is the function that is responsible for making the concrete.
-	concrete interface{} // concrete is the stored instance for singleton bindings.
+	bindType    BindType
+	resolver    interface{} // resolver is the function that is responsible for making the concrete.
+	concrete    interface{} // concrete is the stored instance for singleton bindings.
+	abstraction reflect.Type
+	name        string
+	onResolve   func(reflect.Type, string, interface{})
+	onDispose   func(reflect.Type, string, interface{}) error
+	resolving   bool // guards re-entrant make calls that don't share opt's stack, see make.
 }
 
 // make resolves the binding if needed and returns the resolved concrete.
+// The push/popStack guard lives here rather than at each call site, so
+// every resolution path shares the one cycle guard. resolving is a second,
+// Option-independent guard: Fill, DeepFill and ResolveAll each start their
+// own Option, so a constructor calling back into one of those wouldn't be
+// caught by the stack check alone.
 func (b *binding) make(c Container, opt *Option) (interface{}, error) {
 	if b.concrete != nil {
 		return b.concrete, nil
 	}
 
+	if err := pushStack(opt, b); err != nil {
+		return nil, err
+	}
+	defer popStack(opt)
+
+	if b.resolving {
+		return nil, fmt.Errorf("container: cyclic dependency: %s", bindingLabel(b))
+	}
+	b.resolving = true
+	defer func() { b.resolving = false }()
+
+	concrete, err := c.invoke(b.resolver, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	if b.bindType == delaySingletonType {
-		var err error
-		b.concrete, err = c.invoke(b.resolver, opt)
-		if err != nil {
-			return nil, err
-		}
-		return b.concrete, nil
+		b.concrete = concrete
+		c.recordResolution(b)
 	}
 
-	return c.invoke(b.resolver, opt)
+	if b.onResolve != nil {
+		b.onResolve(b.abstraction, b.name, concrete)
+	}
+
+	return concrete, nil
 }
 
 // Container holds the bindings and provides methods to interact with them.
 // It is the entry point in the package.
-// Use a pointer to make it lazily change state
-type Container map[reflect.Type]map[string]*binding
+// bindings and lifecycle are reference types (map/pointer), so a Container
+// value can be freely copied and passed around while still sharing state
+// with every other copy. parent is a real pointer into an ancestor
+// Container, set up by Scope, so the chain is kept alive by ordinary Go
+// references rather than an address-keyed side table.
+type Container struct {
+	bindings  map[reflect.Type]map[string]*binding
+	parent    *Container
+	lifecycle *lifecycleState
+}
 
 // New creates a new concrete of the Container.
 func New() Container {
-	return make(Container)
+	return Container{
+		bindings:  make(map[reflect.Type]map[string]*binding),
+		lifecycle: newLifecycleState(),
+	}
 }
 
 // bind maps an abstraction to concrete and instantiates if it is a singleton binding.
@@ -55,8 +92,8 @@ func (c Container) bind(resolver interface{}, bindType BindType, opt *Option) er
 
 	// 输出参数简化为只返回一个，如果有必要可以修改为多个
 	if reflectedResolver.NumOut() > 0 {
-		if _, exist := c[reflectedResolver.Out(0)]; !exist {
-			c[reflectedResolver.Out(0)] = make(map[string]*binding)
+		if _, exist := c.bindings[reflectedResolver.Out(0)]; !exist {
+			c.bindings[reflectedResolver.Out(0)] = make(map[string]*binding)
 		}
 	}
 
@@ -70,16 +107,28 @@ func (c Container) bind(resolver interface{}, bindType BindType, opt *Option) er
 	} else {
 		concrete = nil
 	}
-	if c[reflectedResolver.Out(0)][opt.name] != nil {
+	if c.bindings[reflectedResolver.Out(0)][opt.name] != nil {
 		rType := reflectedResolver.Out(0)
-		name := opt.name
-		if opt.name == "" {
-			name = "type"
-		}
-		return fmt.Errorf("container: %s binding [%s] already exists", rType.String(), name)
+		return fmt.Errorf("container: %s binding [%s] already exists", rType.String(), bindingName(opt.name))
+	}
+
+	b := &binding{
+		resolver:    resolver,
+		concrete:    concrete,
+		bindType:    bindType,
+		abstraction: reflectedResolver.Out(0),
+		name:        opt.name,
+		onResolve:   opt.onResolve,
+		onDispose:   opt.onDispose,
 	}
+	c.bindings[reflectedResolver.Out(0)][opt.name] = b
 
-	c[reflectedResolver.Out(0)][opt.name] = &binding{resolver: resolver, concrete: concrete, bindType: bindType}
+	if bindType == singletonType {
+		c.recordResolution(b)
+		if b.onResolve != nil {
+			b.onResolve(b.abstraction, b.name, concrete)
+		}
+	}
 
 	return nil
 }
@@ -129,16 +178,19 @@ func (c Container) arguments(function interface{}, opt *Option) ([]reflect.Value
 	return arguments, nil
 }
 
+// getBinding looks up a binding in c itself, falling back to the parent
+// chain set up by Scope when c is a child container.
 func (c Container) getBinding(t reflect.Type, names []string) (*binding, bool) {
-	src := c[t]
-	if c[t] == nil {
-		panic(fmt.Sprintf("container: no binding found for %s", t.String()))
+	if src, exist := c.bindings[t]; exist {
+		for i := 0; i < len(names); i++ {
+			if val, ok := src[names[i]]; ok {
+				return val, true
+			}
+		}
 	}
 
-	for i := 0; i < len(names); i++ {
-		if val, ok := src[names[i]]; ok {
-			return val, true
-		}
+	if c.parent != nil {
+		return c.parent.getBinding(t, names)
 	}
 
 	return nil, false
@@ -146,9 +198,15 @@ func (c Container) getBinding(t reflect.Type, names []string) (*binding, bool) {
 
 // Reset deletes all the existing bindings and empties the container.
 func (c Container) Reset() {
-	for k := range c {
-		delete(c, k)
+	for k := range c.bindings {
+		delete(c.bindings, k)
 	}
+
+	c.lifecycle.mu.Lock()
+	for k := range c.lifecycle.groups {
+		delete(c.lifecycle.groups, k)
+	}
+	c.lifecycle.mu.Unlock()
 }
 
 func (c Container) singleton(resolver interface{}, opt *Option) error {
@@ -282,24 +340,15 @@ func (c Container) Fill(structure interface{}) error {
 				// container:type -> 按类型进行匹配
 				// container:name -> 按类型+名称进行匹配（外部可访问的属性名字）
 				if t, exist := s.Type().Field(i).Tag.Lookup("container"); exist {
-					subTs := strings.Split(t, ",")
-					names := make([]string, 0)
-
-					if len(subTs) == 0 {
-						names = append(names, "")
-					} else {
-						for _, subT := range subTs {
-							switch subT {
-							case "type":
-								names = append(names, "")
-							case "name":
-								names = append(names, s.Type().Field(i).Name)
-							default:
-								names = append(names, subT)
-							}
+					if groupName, isGroup := groupTag(t); isGroup {
+						if err := c.fillGroupField(f, groupName, opt); err != nil {
+							return err
 						}
+						continue
 					}
 
+					names := tagNames(t, s.Type().Field(i).Name)
+
 					if concrete, exist := c.getBinding(f.Type(), names); exist {
 						instance, _ := concrete.make(c, opt)
 
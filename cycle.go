@@ -0,0 +1,41 @@
+package container
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pushStack pushes b onto opt's resolution chain, or returns a cyclic
+// dependency error if b is already being resolved somewhere up the chain.
+func pushStack(opt *Option, b *binding) error {
+	for _, s := range opt.stack {
+		if s == b {
+			return cycleError(opt.stack, b)
+		}
+	}
+
+	opt.stack = append(opt.stack, b)
+	return nil
+}
+
+// popStack pops the binding pushed by the matching pushStack call.
+func popStack(opt *Option) {
+	opt.stack = opt.stack[:len(opt.stack)-1]
+}
+
+func cycleError(stack []*binding, repeat *binding) error {
+	names := make([]string, 0, len(stack)+1)
+	for _, b := range stack {
+		names = append(names, bindingLabel(b))
+	}
+	names = append(names, bindingLabel(repeat))
+
+	return fmt.Errorf("container: cyclic dependency: %s", strings.Join(names, " -> "))
+}
+
+func bindingLabel(b *binding) string {
+	if b.abstraction == nil {
+		return "?"
+	}
+	return b.abstraction.String()
+}
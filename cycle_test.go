@@ -0,0 +1,79 @@
+package container
+
+import (
+	"strings"
+	"testing"
+)
+
+type cycleA interface {
+	A() string
+}
+
+type cycleB interface {
+	B() string
+}
+
+func TestCycleDirect(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func(a cycleA) cycleA { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	var out cycleA
+	err := c.Resolve(&out)
+	if err == nil {
+		t.Fatal("expected a cyclic dependency error, got nil")
+	}
+	if !strings.Contains(err.Error(), "cyclic dependency") {
+		t.Errorf("expected a cyclic dependency error, got %q", err.Error())
+	}
+}
+
+func TestCycleIndirect(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func(b cycleB) cycleA { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Singleton(func(a cycleA) cycleB { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	var out cycleA
+	err := c.Resolve(&out)
+	if err == nil {
+		t.Fatal("expected a cyclic dependency error, got nil")
+	}
+	if !strings.Contains(err.Error(), "cyclic dependency") {
+		t.Errorf("expected a cyclic dependency error, got %q", err.Error())
+	}
+}
+
+func TestCycleAcrossNamedBindings(t *testing.T) {
+	c := New()
+
+	if err := c.NamedSingleton("svc", func(b cycleB) cycleA { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.NamedSingleton("svc", func(a cycleA) cycleB { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	var out cycleA
+	err := c.NamedResolve("svc", &out)
+	if err == nil {
+		t.Fatal("expected a cyclic dependency error, got nil")
+	}
+	if !strings.Contains(err.Error(), "cyclic dependency") {
+		t.Errorf("expected a cyclic dependency error, got %q", err.Error())
+	}
+}
+
+func TestGetBindingUnknownTypeDoesNotPanic(t *testing.T) {
+	c := New()
+
+	if _, exist := c.getBinding(namerType(), []string{""}); exist {
+		t.Fatal("expected getBinding to report false for a never-bound abstraction")
+	}
+}
@@ -0,0 +1,199 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// override holds the call-scoped values supplied to DeepFill, indexed by the
+// abstraction type they should be preferred over, and then by binding name.
+type override map[reflect.Type]map[string]interface{}
+
+// newOverride builds an override index out of the structs passed to DeepFill.
+// Each override must be a pointer to a struct; every field becomes an entry,
+// keyed by its type and, optionally, a `container:"name"` tag just like Fill.
+func newOverride(structures []interface{}) (override, error) {
+	idx := make(override)
+
+	for _, structure := range structures {
+		receiverType := reflect.TypeOf(structure)
+		if receiverType == nil || receiverType.Kind() != reflect.Ptr || receiverType.Elem().Kind() != reflect.Struct {
+			return nil, errors.New("container: override must be a pointer to a struct")
+		}
+
+		s := reflect.ValueOf(structure).Elem()
+		t := s.Type()
+
+		for i := 0; i < s.NumField(); i++ {
+			names := []string{""}
+			if tag, exist := t.Field(i).Tag.Lookup("container"); exist {
+				names = tagNames(tag, t.Field(i).Name)
+			}
+
+			fieldType := t.Field(i).Type
+			if idx[fieldType] == nil {
+				idx[fieldType] = make(map[string]interface{})
+			}
+
+			value := s.Field(i).Interface()
+			for _, name := range names {
+				idx[fieldType][name] = value
+			}
+		}
+	}
+
+	return idx, nil
+}
+
+// get looks up an override value for the abstraction under any of names, in order.
+func (o override) get(t reflect.Type, names []string) (interface{}, bool) {
+	src, exist := o[t]
+	if !exist {
+		return nil, false
+	}
+
+	for _, name := range names {
+		if val, ok := src[name]; ok {
+			return val, true
+		}
+	}
+
+	return nil, false
+}
+
+// DeepFill behaves like Fill but recurses into nested struct fields, pointer
+// chains, and slices/maps of structs, resolving `container:"..."` tags at
+// every level. overrides are pointers to structs whose fields take
+// precedence over the container's own bindings for the duration of this
+// call, letting request-scoped values (e.g. *http.Request) be threaded into
+// deeply nested service graphs without touching the global container.
+func (c Container) DeepFill(structure interface{}, overrides ...interface{}) error {
+	receiverType := reflect.TypeOf(structure)
+	if receiverType == nil || receiverType.Kind() != reflect.Ptr || receiverType.Elem().Kind() != reflect.Struct {
+		return errors.New("container: invalid structure")
+	}
+
+	idx, err := newOverride(overrides)
+	if err != nil {
+		return err
+	}
+
+	return c.deepFillStruct(reflect.ValueOf(structure).Elem(), idx, make(map[uintptr]bool), defaultOption())
+}
+
+// deepFillStruct fills every tagged field of s, then recurses into every
+// field - tagged or not - in case it holds, or contains, further tagged
+// fields. Each field is rebound through reflect.NewAt/UnsafeAddr up front so
+// the rest of the walk never has to special-case unexported fields: Set and
+// SetMapIndex both work on the rebound Value regardless of export status.
+func (c Container) deepFillStruct(s reflect.Value, idx override, visited map[uintptr]bool, opt *Option) error {
+	t := s.Type()
+
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Field(i)
+		field := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+
+		tag, exist := t.Field(i).Tag.Lookup("container")
+		if !exist {
+			// Untagged itself, but it may still embed fields that carry a
+			// container tag further down (e.g. a plain, unannotated nested
+			// struct) - keep walking instead of stopping at the first field
+			// without a tag of its own.
+			if err := c.deepFillValue(field, idx, visited, opt); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if groupName, isGroup := groupTag(tag); isGroup {
+			if err := c.fillGroupField(field, groupName, opt); err != nil {
+				return err
+			}
+			continue
+		}
+
+		names := tagNames(tag, t.Field(i).Name)
+
+		if instance, ok := idx.get(f.Type(), names); ok {
+			field.Set(reflect.ValueOf(instance))
+		} else if concrete, exist := c.getBinding(f.Type(), names); exist {
+			instance, err := concrete.make(c, opt)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(instance))
+		} else {
+			return fmt.Errorf("container: cannot make %v(%v) field with tags [%s]",
+				t.Field(i).Name, f.Type().String(), strings.Join(names, ","))
+		}
+
+		if err := c.deepFillValue(field, idx, visited, opt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deepFillValue walks into a resolved field value and fills any tagged
+// struct it finds, be it a direct struct, a pointer, or a slice/map of them.
+// Pointers are tracked in visited to break cycles in the struct graph.
+func (c Container) deepFillValue(v reflect.Value, idx override, visited map[uintptr]bool, opt *Option) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return nil
+		}
+
+		ptr := v.Pointer()
+		if visited[ptr] {
+			return nil
+		}
+		visited[ptr] = true
+
+		return c.deepFillStruct(v.Elem(), idx, visited, opt)
+
+	case reflect.Struct:
+		if !v.CanAddr() {
+			return nil
+		}
+		return c.deepFillStruct(v, idx, visited, opt)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := c.deepFillValue(v.Index(i), idx, visited, opt); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			for _, k := range v.MapKeys() {
+				if err := c.deepFillValue(v.MapIndex(k), idx, visited, opt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		// Map values aren't addressable (MapIndex always returns a copy), so
+		// a struct-valued map can't be filled in place like a slice element
+		// can. Copy each value out to an addressable temporary, fill that,
+		// and write it back with SetMapIndex.
+		for _, k := range v.MapKeys() {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.MapIndex(k))
+
+			if err := c.deepFillStruct(elem, idx, visited, opt); err != nil {
+				return err
+			}
+
+			v.SetMapIndex(k, elem)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package container
+
+import "testing"
+
+type deepFillService interface {
+	Describe() string
+}
+
+type deepFillServiceImpl string
+
+func (d deepFillServiceImpl) Describe() string { return string(d) }
+
+type deepFillLeaf struct {
+	Svc deepFillService `container:"type"`
+}
+
+func TestDeepFillOverrideTakesPrecedence(t *testing.T) {
+	c := New()
+	if err := c.Singleton(func() deepFillService { return deepFillServiceImpl("from-container") }); err != nil {
+		t.Fatal(err)
+	}
+
+	var target deepFillLeaf
+	override := &deepFillLeaf{Svc: deepFillServiceImpl("from-override")}
+	if err := c.DeepFill(&target, override); err != nil {
+		t.Fatal(err)
+	}
+
+	if target.Svc.Describe() != "from-override" {
+		t.Errorf("expected the override to take precedence, got %q", target.Svc.Describe())
+	}
+}
+
+// deepFillOuter embeds Nested without a container tag of its own: DeepFill
+// must still recurse into it to fill Nested.Svc, which does carry one.
+type deepFillOuter struct {
+	Nested deepFillLeaf
+}
+
+func TestDeepFillRecursesIntoUntaggedNestedStruct(t *testing.T) {
+	c := New()
+	if err := c.Singleton(func() deepFillService { return deepFillServiceImpl("nested") }); err != nil {
+		t.Fatal(err)
+	}
+
+	var target deepFillOuter
+	if err := c.DeepFill(&target); err != nil {
+		t.Fatal(err)
+	}
+
+	if target.Nested.Svc == nil || target.Nested.Svc.Describe() != "nested" {
+		t.Errorf("expected DeepFill to recurse into the untagged nested struct, got %v", target.Nested.Svc)
+	}
+}
+
+type deepFillMapOuter struct {
+	Services map[string]deepFillLeaf
+}
+
+func TestDeepFillFillsStructValuedMap(t *testing.T) {
+	c := New()
+	if err := c.Singleton(func() deepFillService { return deepFillServiceImpl("mapped") }); err != nil {
+		t.Fatal(err)
+	}
+
+	target := deepFillMapOuter{
+		Services: map[string]deepFillLeaf{"a": {}, "b": {}},
+	}
+	if err := c.DeepFill(&target); err != nil {
+		t.Fatal(err)
+	}
+
+	for key, leaf := range target.Services {
+		if leaf.Svc == nil || leaf.Svc.Describe() != "mapped" {
+			t.Errorf("expected map entry %q to be filled, got %v", key, leaf.Svc)
+		}
+	}
+}
+
+// deepFillCyclic can point back to itself through Self; DeepFill must
+// detect that with its visited-pointer set instead of recursing forever.
+type deepFillCyclic struct {
+	Self *deepFillCyclic
+	Svc  deepFillService `container:"type"`
+}
+
+func TestDeepFillBreaksPointerCycle(t *testing.T) {
+	c := New()
+	if err := c.Singleton(func() deepFillService { return deepFillServiceImpl("cyclic") }); err != nil {
+		t.Fatal(err)
+	}
+
+	target := &deepFillCyclic{}
+	target.Self = target
+
+	if err := c.DeepFill(target); err != nil {
+		t.Fatal(err)
+	}
+
+	if target.Svc == nil || target.Svc.Describe() != "cyclic" {
+		t.Errorf("expected the cyclic struct's own field to be filled, got %v", target.Svc)
+	}
+}
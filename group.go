@@ -0,0 +1,155 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// Group registers resolver under groupName for its abstraction (the
+// resolver's return type), alongside any other resolver already registered
+// in that group. Use ResolveAll to fetch every concrete in the group.
+func (c Container) Group(groupName string, resolver interface{}, opt ...OptionFunc) error {
+	option := LoadOption(opt...)
+
+	reflectedResolver := reflect.TypeOf(resolver)
+	if reflectedResolver == nil || reflectedResolver.Kind() != reflect.Func || reflectedResolver.NumOut() == 0 {
+		return errors.New("container: the resolver must be a function")
+	}
+
+	abstraction := reflectedResolver.Out(0)
+	bindType := delaySingletonType
+	if !option.delay {
+		bindType = singletonType
+	}
+
+	var concrete interface{}
+	if bindType == singletonType {
+		var err error
+		concrete, err = c.invoke(resolver, option)
+		if err != nil {
+			return err
+		}
+	}
+
+	b := &binding{
+		resolver:    resolver,
+		concrete:    concrete,
+		bindType:    bindType,
+		abstraction: abstraction,
+		name:        groupName,
+		onResolve:   option.onResolve,
+		onDispose:   option.onDispose,
+	}
+
+	c.lifecycle.mu.Lock()
+	if c.lifecycle.groups[abstraction] == nil {
+		c.lifecycle.groups[abstraction] = make(map[string][]*binding)
+	}
+	c.lifecycle.groups[abstraction][groupName] = append(c.lifecycle.groups[abstraction][groupName], b)
+	c.lifecycle.mu.Unlock()
+
+	if bindType == singletonType {
+		c.recordResolution(b)
+		if b.onResolve != nil {
+			b.onResolve(abstraction, groupName, concrete)
+		}
+	}
+
+	return nil
+}
+
+// ResolveAll fills target, a pointer to a slice, with every concrete
+// registered under groupName for the slice's element type.
+func (c Container) ResolveAll(target interface{}, groupName string, opt ...OptionFunc) error {
+	option := LoadOption(opt...)
+
+	receiverType := reflect.TypeOf(target)
+	if receiverType == nil || receiverType.Kind() != reflect.Ptr || receiverType.Elem().Kind() != reflect.Slice {
+		return errors.New("container: target must be a pointer to a slice")
+	}
+
+	out, err := c.resolveGroup(receiverType.Elem(), groupName, option)
+	if err != nil {
+		return err
+	}
+
+	reflect.ValueOf(target).Elem().Set(out)
+
+	return nil
+}
+
+// lookupGroup returns the bindings registered under groupName for
+// abstraction in c itself, falling back to the parent chain set up by
+// Scope - mirroring getBinding, since Group always writes to the
+// receiver's own lifecycle state the way bind writes to its own bindings
+// map. The slice is copied out under the lock so the caller can range over
+// it unlocked.
+func (c Container) lookupGroup(abstraction reflect.Type, groupName string) ([]*binding, bool) {
+	c.lifecycle.mu.Lock()
+	bindings, exist := c.lifecycle.groups[abstraction][groupName]
+	snapshot := append([]*binding(nil), bindings...)
+	c.lifecycle.mu.Unlock()
+
+	if exist {
+		return snapshot, true
+	}
+
+	if c.parent != nil {
+		return c.parent.lookupGroup(abstraction, groupName)
+	}
+
+	return nil, false
+}
+
+// resolveGroup makes every concrete registered under groupName for
+// sliceType's element type and returns them as a slice of sliceType.
+func (c Container) resolveGroup(sliceType reflect.Type, groupName string, opt *Option) (reflect.Value, error) {
+	abstraction := sliceType.Elem()
+
+	snapshot, exist := c.lookupGroup(abstraction, groupName)
+	if !exist {
+		return reflect.Value{}, fmt.Errorf("container: no group [%s] found for %s", groupName, abstraction.String())
+	}
+
+	out := reflect.MakeSlice(sliceType, 0, len(snapshot))
+	for _, b := range snapshot {
+		concrete, err := b.make(c, opt)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		out = reflect.Append(out, reflect.ValueOf(concrete))
+	}
+
+	return out, nil
+}
+
+// groupTag reports whether tag is a `container:"group=name"` tag, and if so
+// the group name it names.
+func groupTag(tag string) (string, bool) {
+	if !strings.HasPrefix(tag, "group=") {
+		return "", false
+	}
+	return strings.TrimPrefix(tag, "group="), true
+}
+
+// fillGroupField resolves every concrete in groupName into f, which must be
+// a slice of the group's abstraction type, via unsafe.Pointer so unexported
+// fields can be set just like Fill does for ordinary tagged fields.
+func (c Container) fillGroupField(f reflect.Value, groupName string, opt *Option) error {
+	if f.Kind() != reflect.Slice {
+		return fmt.Errorf("container: group field must be a slice, got %s", f.Type().String())
+	}
+
+	out, err := c.resolveGroup(f.Type(), groupName, opt)
+	if err != nil {
+		return err
+	}
+
+	ptr := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+	ptr.Set(out)
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package container
+
+import (
+	"strings"
+	"testing"
+)
+
+type groupHandler interface {
+	Handle() string
+}
+
+type groupHandlerFunc func() string
+
+func (f groupHandlerFunc) Handle() string { return f() }
+
+func TestGroupResolveAllHappyPath(t *testing.T) {
+	c := New()
+
+	if err := c.Group("handlers", func() groupHandler { return groupHandlerFunc(func() string { return "a" }) }); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Group("handlers", func() groupHandler { return groupHandlerFunc(func() string { return "b" }) }); err != nil {
+		t.Fatal(err)
+	}
+
+	var handlers []groupHandler
+	if err := c.ResolveAll(&handlers, "handlers"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(handlers) != 2 {
+		t.Fatalf("expected 2 handlers, got %d", len(handlers))
+	}
+	if handlers[0].Handle() != "a" || handlers[1].Handle() != "b" {
+		t.Errorf("expected handlers in registration order [a b], got [%s %s]", handlers[0].Handle(), handlers[1].Handle())
+	}
+}
+
+func TestGroupResolveAllUnknownGroupErrors(t *testing.T) {
+	c := New()
+
+	var handlers []groupHandler
+	if err := c.ResolveAll(&handlers, "handlers"); err == nil {
+		t.Fatal("expected an error for a group with no registered members")
+	}
+}
+
+type groupFillTarget struct {
+	Handlers []groupHandler `container:"group=handlers"`
+}
+
+func TestGroupTagOnFill(t *testing.T) {
+	c := New()
+
+	if err := c.Group("handlers", func() groupHandler { return groupHandlerFunc(func() string { return "a" }) }); err != nil {
+		t.Fatal(err)
+	}
+
+	var target groupFillTarget
+	if err := c.Fill(&target); err != nil {
+		t.Fatal(err)
+	}
+	if len(target.Handlers) != 1 || target.Handlers[0].Handle() != "a" {
+		t.Errorf("expected Fill to inject the group, got %v", target.Handlers)
+	}
+}
+
+type groupDeepFillOuter struct {
+	Inner groupFillTarget
+}
+
+func TestGroupTagOnDeepFill(t *testing.T) {
+	c := New()
+
+	if err := c.Group("handlers", func() groupHandler { return groupHandlerFunc(func() string { return "a" }) }); err != nil {
+		t.Fatal(err)
+	}
+
+	var target groupDeepFillOuter
+	if err := c.DeepFill(&target); err != nil {
+		t.Fatal(err)
+	}
+	if len(target.Inner.Handlers) != 1 || target.Inner.Handlers[0].Handle() != "a" {
+		t.Errorf("expected DeepFill to inject the group on a nested field, got %v", target.Inner.Handlers)
+	}
+}
+
+func TestGroupResolveAllFallsBackToParent(t *testing.T) {
+	parent := New()
+	if err := parent.Group("handlers", func() groupHandler { return groupHandlerFunc(func() string { return "a" }) }); err != nil {
+		t.Fatal(err)
+	}
+	if err := parent.Group("handlers", func() groupHandler { return groupHandlerFunc(func() string { return "b" }) }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+
+	var handlers []groupHandler
+	if err := child.ResolveAll(&handlers, "handlers"); err != nil {
+		t.Fatal(err)
+	}
+	if len(handlers) != 2 {
+		t.Fatalf("expected the child to see the parent's 2 group members, got %d", len(handlers))
+	}
+}
+
+// TestGroupResolveAllDetectsCycle reproduces a middleware wanting "the rest
+// of the chain": the group member's own constructor resolves the very
+// group it is being constructed for. That must fail fast with a cyclic
+// dependency error instead of recursing until the goroutine's stack
+// overflows.
+func TestGroupResolveAllDetectsCycle(t *testing.T) {
+	c := New()
+
+	if err := c.Group("handlers", func() (groupHandler, error) {
+		var rest []groupHandler
+		if err := c.ResolveAll(&rest, "handlers"); err != nil {
+			return nil, err
+		}
+		return groupHandlerFunc(func() string { return "cyclic" }), nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var handlers []groupHandler
+	err := c.ResolveAll(&handlers, "handlers")
+	if err == nil {
+		t.Fatal("expected a cyclic dependency error, got nil")
+	}
+	if !strings.Contains(err.Error(), "cyclic dependency") {
+		t.Errorf("expected a cyclic dependency error, got %q", err.Error())
+	}
+}
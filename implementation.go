@@ -0,0 +1,76 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// implementation registers instance as a ready-made singleton under every
+// abstraction in opt.as, or under its own concrete type if none were given.
+func (c Container) implementation(instance interface{}, opt *Option) error {
+	if instance == nil {
+		return errors.New("container: instance must not be nil")
+	}
+
+	concreteType := reflect.TypeOf(instance)
+
+	abstractions := opt.as
+	if len(abstractions) == 0 {
+		abstractions = []reflect.Type{concreteType}
+	}
+
+	// Validate every abstraction before binding any of them, so a failure
+	// partway through (e.g. the second of two As() types already bound)
+	// never leaves the first abstraction registered with no way for the
+	// caller to know about or undo it.
+	for _, abstraction := range abstractions {
+		if abstraction.Kind() == reflect.Interface && !concreteType.Implements(abstraction) {
+			return fmt.Errorf("container: %s does not implement %s", concreteType.String(), abstraction.String())
+		}
+
+		if c.bindings[abstraction][opt.name] != nil {
+			return fmt.Errorf("container: %s binding [%s] already exists", abstraction.String(), bindingName(opt.name))
+		}
+	}
+
+	for _, abstraction := range abstractions {
+		if c.bindings[abstraction] == nil {
+			c.bindings[abstraction] = make(map[string]*binding)
+		}
+
+		b := &binding{
+			concrete:    instance,
+			bindType:    singletonType,
+			abstraction: abstraction,
+			name:        opt.name,
+			onResolve:   opt.onResolve,
+			onDispose:   opt.onDispose,
+		}
+		c.bindings[abstraction][opt.name] = b
+
+		c.recordResolution(b)
+		if b.onResolve != nil {
+			b.onResolve(b.abstraction, b.name, instance)
+		}
+	}
+
+	return nil
+}
+
+// Implementation registers an already-constructed instance as a singleton,
+// under every abstraction supplied via As, or its concrete type otherwise.
+// Unlike Singleton, it takes the value directly instead of a resolver
+// function, so pre-built values such as a *sql.DB or a logger can be wired
+// into the graph without wrapping them in a closure.
+func (c Container) Implementation(instance interface{}, opt ...OptionFunc) error {
+	option := LoadOption(opt...)
+	return c.implementation(instance, option)
+}
+
+// NamedImplementation registers a named, already-constructed instance. See Implementation.
+func (c Container) NamedImplementation(name string, instance interface{}, opt ...OptionFunc) error {
+	option := LoadOption(opt...)
+	option.name = name
+	return c.implementation(instance, option)
+}
@@ -0,0 +1,89 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+)
+
+type implA interface{ A() }
+type implB interface{ B() }
+
+type implValue struct{}
+
+func (implValue) A() {}
+func (implValue) B() {}
+
+func TestImplementationBindsUnderConcreteTypeByDefault(t *testing.T) {
+	c := New()
+	instance := &implValue{}
+
+	if err := c.Implementation(instance); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.HasBinding(reflect.TypeOf(instance), "") {
+		t.Error("expected Implementation to bind under the instance's concrete type")
+	}
+}
+
+func TestImplementationAsRestrictsToGivenInterfaces(t *testing.T) {
+	c := New()
+	instance := implValue{}
+
+	if err := c.Implementation(instance, As(new(implA))); err != nil {
+		t.Fatal(err)
+	}
+
+	var a implA
+	if err := c.Resolve(&a); err != nil {
+		t.Fatal(err)
+	}
+
+	var b implB
+	if err := c.Resolve(&b); err == nil {
+		t.Error("expected implB to not be bound since it wasn't named in As()")
+	}
+}
+
+// TestImplementationPartialAsFailureLeavesNothingBound reproduces the bug
+// the chunk0-3 fix closed: Implementation(x, As(implA, implB)) where implB
+// already has a binding used to bind implA successfully before failing on
+// implB, leaving implA registered with no way for the caller to know or
+// undo it from the returned error.
+func TestImplementationPartialAsFailureLeavesNothingBound(t *testing.T) {
+	c := New()
+
+	if err := c.Implementation(implValue{}, As(new(implB))); err != nil {
+		t.Fatal(err)
+	}
+
+	err := c.Implementation(implValue{}, As(new(implA), new(implB)))
+	if err == nil {
+		t.Fatal("expected an error since implB is already bound")
+	}
+
+	if c.HasBinding(reflect.TypeOf((*implA)(nil)).Elem(), "") {
+		t.Error("expected implA to not be bound after the As() call failed on implB")
+	}
+}
+
+func TestImplementationNilInstanceErrors(t *testing.T) {
+	c := New()
+	if err := c.Implementation(nil); err == nil {
+		t.Fatal("expected an error for a nil instance")
+	}
+}
+
+func TestNamedImplementationBindsUnderName(t *testing.T) {
+	c := New()
+	instance := implValue{}
+
+	if err := c.NamedImplementation("primary", instance, As(new(implA))); err != nil {
+		t.Fatal(err)
+	}
+
+	var a implA
+	if err := c.NamedResolve("primary", &a); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+package container
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// BindingInfo is a safe, read-only view of a registered binding, returned by
+// ListBindings so callers can inspect the graph without reaching into the
+// unexported binding map.
+type BindingInfo struct {
+	BindType     BindType
+	ResolverType reflect.Type
+	Resolved     bool
+}
+
+// ListBindings returns every name→BindingInfo registered under abstraction.
+func (c Container) ListBindings(abstraction reflect.Type) (map[string]BindingInfo, error) {
+	src, exist := c.bindings[abstraction]
+	if !exist {
+		return nil, fmt.Errorf("container: no binding found for %s", abstraction.String())
+	}
+
+	out := make(map[string]BindingInfo, len(src))
+	for name, b := range src {
+		out[name] = BindingInfo{
+			BindType:     b.bindType,
+			ResolverType: reflect.TypeOf(b.resolver),
+			Resolved:     b.concrete != nil,
+		}
+	}
+
+	return out, nil
+}
+
+// HasBinding reports whether abstraction has a binding registered under name
+// directly on c. Like ListBindings, Unbind and Types, it does not consult
+// c's parent (see Scope).
+func (c Container) HasBinding(abstraction reflect.Type, name string) bool {
+	_, exist := c.bindings[abstraction][name]
+	return exist
+}
+
+// Unbind removes the binding registered under name for abstraction.
+func (c Container) Unbind(abstraction reflect.Type, name string) error {
+	src, exist := c.bindings[abstraction]
+	if !exist {
+		return fmt.Errorf("container: no binding found for %s", abstraction.String())
+	}
+
+	if _, exist := src[name]; !exist {
+		return fmt.Errorf("container: %s binding [%s] does not exist", abstraction.String(), bindingName(name))
+	}
+
+	delete(src, name)
+
+	return nil
+}
+
+// Types lists every abstraction currently bound in the container.
+func (c Container) Types() []reflect.Type {
+	out := make([]reflect.Type, 0, len(c.bindings))
+	for t, names := range c.bindings {
+		if len(names) == 0 {
+			continue
+		}
+		out = append(out, t)
+	}
+
+	return out
+}
@@ -0,0 +1,155 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+)
+
+type introspectionGreeter interface {
+	Greet() string
+}
+
+type introspectionGreeterImpl struct{}
+
+func (introspectionGreeterImpl) Greet() string { return "hi" }
+
+func greeterType() reflect.Type {
+	return reflect.TypeOf((*introspectionGreeter)(nil)).Elem()
+}
+
+func TestListBindingsUnknownType(t *testing.T) {
+	c := New()
+
+	if _, err := c.ListBindings(greeterType()); err == nil {
+		t.Fatal("expected an error for an unbound abstraction, got nil")
+	}
+}
+
+func TestListBindingsReturnsEveryName(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.NamedSingleton("loud", func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+
+	bindings, err := c.ListBindings(greeterType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bindings) != 2 {
+		t.Fatalf("expected 2 registered names, got %d", len(bindings))
+	}
+
+	for _, name := range []string{"", "loud"} {
+		info, ok := bindings[name]
+		if !ok {
+			t.Fatalf("expected a binding registered under %q", name)
+		}
+		if info.BindType != delaySingletonType {
+			t.Errorf("expected %q to be a delay singleton by default, got %v", name, info.BindType)
+		}
+	}
+}
+
+func TestHasBindingUnknownType(t *testing.T) {
+	c := New()
+
+	// getBinding used to panic on a type with no entry at all in c.bindings;
+	// HasBinding must report it as simply absent instead.
+	if c.HasBinding(greeterType(), "") {
+		t.Fatal("expected HasBinding to report false for a never-bound abstraction")
+	}
+}
+
+func TestHasBindingKnownNames(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.HasBinding(greeterType(), "") {
+		t.Error("expected HasBinding to report true for the registered name")
+	}
+	if c.HasBinding(greeterType(), "unregistered") {
+		t.Error("expected HasBinding to report false for a name that was never registered")
+	}
+}
+
+func TestUnbindRemovesBinding(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Unbind(greeterType(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.HasBinding(greeterType(), "") {
+		t.Error("expected the binding to be gone after Unbind")
+	}
+}
+
+func TestUnbindUnknownBindingErrors(t *testing.T) {
+	c := New()
+
+	if err := c.Unbind(greeterType(), ""); err == nil {
+		t.Fatal("expected an error unbinding an abstraction that was never registered")
+	}
+
+	if err := c.Singleton(func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Unbind(greeterType(), "missing-name"); err == nil {
+		t.Fatal("expected an error unbinding a name that was never registered")
+	}
+}
+
+func TestTypesListsEveryAbstraction(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+
+	types := c.Types()
+	if len(types) != 1 || types[0] != greeterType() {
+		t.Fatalf("expected Types to report [%v], got %v", greeterType(), types)
+	}
+}
+
+func TestIntrospectionIsChildOnly(t *testing.T) {
+	parent := New()
+	if err := parent.Singleton(func() introspectionGreeter { return introspectionGreeterImpl{} }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+
+	// Per Scope's doc comment, HasBinding/ListBindings/Types/Unbind only
+	// ever see the child's own bindings, even though Resolve/Fill would
+	// fall back to the parent for this same type.
+	if child.HasBinding(greeterType(), "") {
+		t.Error("expected HasBinding to ignore the parent's binding")
+	}
+	if _, err := child.ListBindings(greeterType()); err == nil {
+		t.Error("expected ListBindings to ignore the parent's binding")
+	}
+	if len(child.Types()) != 0 {
+		t.Errorf("expected Types to be empty on a fresh child, got %v", child.Types())
+	}
+	if err := child.Unbind(greeterType(), ""); err == nil {
+		t.Error("expected Unbind to report the parent's binding as not found on the child")
+	}
+
+	// The parent itself is unaffected and still sees its own binding.
+	if !parent.HasBinding(greeterType(), "") {
+		t.Error("expected the parent's own binding to remain visible")
+	}
+}
@@ -0,0 +1,75 @@
+package container
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Disposable is implemented by singletons that hold resources needing
+// explicit teardown (connections, files, background goroutines). Close
+// recognizes it automatically, on top of any OnDispose hook.
+type Disposable interface {
+	Close() error
+}
+
+// lifecycleState holds the mutable, shared-by-reference state a Container
+// needs beyond its bindings map: the singletons it has resolved, in
+// materialization order, and (see group.go) its group bindings. It is
+// allocated once by New/Scope and referenced by pointer, so every copy of a
+// Container value sees the same state.
+type lifecycleState struct {
+	mu       sync.Mutex
+	resolved []*binding
+	groups   map[reflect.Type]map[string][]*binding
+}
+
+func newLifecycleState() *lifecycleState {
+	return &lifecycleState{groups: make(map[reflect.Type]map[string][]*binding)}
+}
+
+// recordResolution notes, in materialization order, that b's concrete has
+// been produced. Because arguments always resolves a binding's dependencies
+// before the binding itself, this order is already a valid topological
+// order; Close walks it in reverse so dependents are torn down before what
+// they depend on.
+func (c Container) recordResolution(b *binding) {
+	c.lifecycle.mu.Lock()
+	c.lifecycle.resolved = append(c.lifecycle.resolved, b)
+	c.lifecycle.mu.Unlock()
+}
+
+// Close tears down every singleton concrete this container has resolved, in
+// reverse materialization order: it calls any OnDispose hook registered at
+// bind time, then Close on concretes implementing Disposable. Errors are
+// aggregated so one failing teardown doesn't stop the rest.
+func (c Container) Close() error {
+	c.lifecycle.mu.Lock()
+	order := c.lifecycle.resolved
+	c.lifecycle.resolved = nil
+	c.lifecycle.mu.Unlock()
+
+	var errs []string
+	for i := len(order) - 1; i >= 0; i-- {
+		b := order[i]
+
+		if b.onDispose != nil {
+			if err := b.onDispose(b.abstraction, b.name, b.concrete); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if disposable, ok := b.concrete.(Disposable); ok {
+			if err := disposable.Close(); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New("container: close: " + strings.Join(errs, "; "))
+	}
+
+	return nil
+}
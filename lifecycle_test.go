@@ -0,0 +1,165 @@
+package container
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type lifecycleDep interface {
+	Describe() string
+}
+
+type lifecycleDepImpl string
+
+func (l lifecycleDepImpl) Describe() string { return string(l) }
+
+type lifecycleConsumer interface {
+	Describe() string
+}
+
+type lifecycleConsumerImpl struct {
+	dep lifecycleDep
+}
+
+func (l lifecycleConsumerImpl) Describe() string { return "consumer(" + l.dep.Describe() + ")" }
+
+// TestCloseTearsDownInReverseMaterializationOrder resolves a singleton whose
+// constructor depends on another singleton, so the dependency is necessarily
+// materialized first. Close must invoke the dependent's OnDispose before the
+// dependency's, matching lifecycle.go's doc comment: dependents are torn
+// down before what they depend on.
+func TestCloseTearsDownInReverseMaterializationOrder(t *testing.T) {
+	c := New()
+
+	var torndown []string
+	record := func(name string) func(reflect.Type, string, interface{}) error {
+		return func(reflect.Type, string, interface{}) error {
+			torndown = append(torndown, name)
+			return nil
+		}
+	}
+
+	if err := c.Singleton(func() lifecycleDep { return lifecycleDepImpl("dep") },
+		OnDispose(record("dep"))); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Singleton(func(d lifecycleDep) lifecycleConsumer { return lifecycleConsumerImpl{dep: d} },
+		OnDispose(record("consumer"))); err != nil {
+		t.Fatal(err)
+	}
+
+	var consumer lifecycleConsumer
+	if err := c.Resolve(&consumer); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(torndown) != 2 || torndown[0] != "consumer" || torndown[1] != "dep" {
+		t.Errorf("expected teardown order [consumer dep], got %v", torndown)
+	}
+}
+
+type lifecycleDisposable struct {
+	closed *bool
+}
+
+func (l lifecycleDisposable) Close() error {
+	*l.closed = true
+	return nil
+}
+
+// TestCloseCallsDisposableConcrete confirms Close falls through to a
+// resolved concrete's own Close method when it implements Disposable, on
+// top of any OnDispose hook.
+func TestCloseCallsDisposableConcrete(t *testing.T) {
+	c := New()
+
+	closed := false
+	if err := c.Singleton(func() lifecycleDisposable { return lifecycleDisposable{closed: &closed} }); err != nil {
+		t.Fatal(err)
+	}
+
+	var d lifecycleDisposable
+	if err := c.Resolve(&d); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !closed {
+		t.Error("expected Close to call the resolved concrete's Disposable.Close")
+	}
+}
+
+type lifecycleFailingDisposable struct {
+	msg string
+}
+
+func (l lifecycleFailingDisposable) Close() error {
+	return errors.New(l.msg)
+}
+
+// TestCloseAggregatesErrors confirms a failing teardown doesn't stop the
+// rest, and that all the failures show up in the error Close returns.
+func TestCloseAggregatesErrors(t *testing.T) {
+	c := New()
+
+	if err := c.Singleton(func() lifecycleFailingDisposable { return lifecycleFailingDisposable{msg: "first failed"} }); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.NamedSingleton("second", func() lifecycleFailingDisposable { return lifecycleFailingDisposable{msg: "second failed"} }); err != nil {
+		t.Fatal(err)
+	}
+
+	var first lifecycleFailingDisposable
+	if err := c.Resolve(&first); err != nil {
+		t.Fatal(err)
+	}
+	var second lifecycleFailingDisposable
+	if err := c.NamedResolve("second", &second); err != nil {
+		t.Fatal(err)
+	}
+
+	err := c.Close()
+	if err == nil {
+		t.Fatal("expected Close to return an aggregated error")
+	}
+	if !strings.Contains(err.Error(), "first failed") || !strings.Contains(err.Error(), "second failed") {
+		t.Errorf("expected both failures in the aggregated error, got %q", err.Error())
+	}
+}
+
+// TestOnResolveHookInvokedOnMaterialization confirms OnResolve fires with
+// the binding's abstraction, name and concrete the moment it is made.
+func TestOnResolveHookInvokedOnMaterialization(t *testing.T) {
+	c := New()
+
+	var gotName string
+	var gotConcrete interface{}
+	if err := c.Singleton(func() lifecycleDep { return lifecycleDepImpl("resolved") },
+		OnResolve(func(_ reflect.Type, name string, concrete interface{}) {
+			gotName = name
+			gotConcrete = concrete
+		})); err != nil {
+		t.Fatal(err)
+	}
+
+	var dep lifecycleDep
+	if err := c.Resolve(&dep); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotName != "" {
+		t.Errorf("expected the unnamed binding's hook to see an empty name, got %q", gotName)
+	}
+	if gotConcrete == nil || gotConcrete.(lifecycleDep).Describe() != "resolved" {
+		t.Errorf("expected the hook to see the resolved concrete, got %v", gotConcrete)
+	}
+}
@@ -1,10 +1,18 @@
 package container
 
-import "strings"
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
 
 type Option struct {
-	name  string
-	delay bool
+	name      string
+	delay     bool
+	as        []reflect.Type
+	onResolve func(reflect.Type, string, interface{})
+	onDispose func(reflect.Type, string, interface{}) error
+	stack     []*binding // tracks the chain of bindings being resolved, to detect cycles
 }
 
 type OptionFunc func(*Option) error
@@ -41,6 +49,71 @@ func LoadOption(opt ...OptionFunc) *Option {
 //	}
 //}
 
+// As restricts an Implementation binding to the given interface types instead
+// of the instance's own concrete type, e.g. As(new(io.Writer), new(io.Closer)).
+func As(abstractions ...interface{}) OptionFunc {
+	return func(option *Option) error {
+		for _, a := range abstractions {
+			t := reflect.TypeOf(a)
+			if t == nil || t.Kind() != reflect.Ptr {
+				return errors.New("container: As expects pointers to the abstraction types, e.g. As(new(io.Writer))")
+			}
+			option.as = append(option.as, t.Elem())
+		}
+		return nil
+	}
+}
+
+// OnResolve registers a hook invoked with the binding's abstraction, name and
+// concrete value each time the binding produces a concrete: once for a
+// singleton (at bind time, or on first resolve when delayed) and on every
+// resolve for a transient. Later resolves of a cached singleton do not call it.
+func OnResolve(hook func(reflect.Type, string, interface{})) OptionFunc {
+	return func(option *Option) error {
+		option.onResolve = hook
+		return nil
+	}
+}
+
+// OnDispose registers a tear-down hook invoked with the binding's
+// abstraction, name and concrete value when the owning container is Closed.
+func OnDispose(hook func(reflect.Type, string, interface{}) error) OptionFunc {
+	return func(option *Option) error {
+		option.onDispose = hook
+		return nil
+	}
+}
+
+// bindingName returns the display name used in error messages for a binding,
+// falling back to "type" for the unnamed (type-keyed) binding.
+func bindingName(name string) string {
+	if name == "" {
+		return "type"
+	}
+	return name
+}
+
+// tagNames parses a `container:"..."` struct tag into the list of binding
+// names it should be resolved against, in order. "type" resolves to the
+// unnamed (type-keyed) binding and "name" resolves to the field's own name.
+func tagNames(tag string, fieldName string) []string {
+	subTs := strings.Split(tag, ",")
+	names := make([]string, 0, len(subTs))
+
+	for _, subT := range subTs {
+		switch subT {
+		case "type":
+			names = append(names, "")
+		case "name":
+			names = append(names, fieldName)
+		default:
+			names = append(names, subT)
+		}
+	}
+
+	return names
+}
+
 func toNames(src string) []string {
 	// 如果为空那么则是降级寻找默认注册类型
 	if len(src) == 0 {
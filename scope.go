@@ -0,0 +1,19 @@
+package container
+
+import "reflect"
+
+// Scope returns a new child container that resolves bindings from itself
+// first, falling back to c (and its own ancestors, if any) when a binding is
+// missing. This enables per-request DI scopes: singletons live on the root
+// container while transients and overrides live on the child and are
+// dropped along with it once the request ends. Unbind, Reset and the
+// introspection methods only ever see the child's own bindings.
+func (c Container) Scope() Container {
+	parent := c
+
+	return Container{
+		bindings:  make(map[reflect.Type]map[string]*binding),
+		parent:    &parent,
+		lifecycle: newLifecycleState(),
+	}
+}
@@ -0,0 +1,145 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+)
+
+type scopeNamer interface {
+	Name() string
+}
+
+type scopeStaticNamer string
+
+func (s scopeStaticNamer) Name() string { return string(s) }
+
+func namerType() reflect.Type {
+	return reflect.TypeOf((*scopeNamer)(nil)).Elem()
+}
+
+func TestScopeChildShadowsParentBinding(t *testing.T) {
+	parent := New()
+	if err := parent.Singleton(func() scopeNamer { return scopeStaticNamer("parent") }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+	if err := child.Singleton(func() scopeNamer { return scopeStaticNamer("child") }); err != nil {
+		t.Fatal(err)
+	}
+
+	var fromChild scopeNamer
+	if err := child.Resolve(&fromChild); err != nil {
+		t.Fatal(err)
+	}
+	if fromChild.Name() != "child" {
+		t.Errorf("expected the child's own binding to win, got %q", fromChild.Name())
+	}
+
+	var fromParent scopeNamer
+	if err := parent.Resolve(&fromParent); err != nil {
+		t.Fatal(err)
+	}
+	if fromParent.Name() != "parent" {
+		t.Errorf("expected the parent to be unaffected by the child's shadowing binding, got %q", fromParent.Name())
+	}
+}
+
+func TestScopeParentSingletonResolvesChildOnlyDependency(t *testing.T) {
+	parent := New()
+	// Delay singleton (the default): the resolver only runs on first
+	// resolve, so it can depend on a binding that doesn't exist yet on
+	// parent at bind time, as long as it exists by the time it's resolved
+	// through a child.
+	if err := parent.Singleton(func(n scopeNamer) string { return "hello " + n.Name() }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+	if err := child.Transient(func() scopeNamer { return scopeStaticNamer("child-only") }); err != nil {
+		t.Fatal(err)
+	}
+
+	var greeting string
+	if err := child.Resolve(&greeting); err != nil {
+		t.Fatal(err)
+	}
+	if greeting != "hello child-only" {
+		t.Errorf("expected the parent singleton to see the child-only dependency, got %q", greeting)
+	}
+}
+
+func TestScopeUnbindDoesNotAffectParent(t *testing.T) {
+	parent := New()
+	if err := parent.Singleton(func() scopeNamer { return scopeStaticNamer("parent") }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+	if err := child.Singleton(func() scopeNamer { return scopeStaticNamer("child") }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := child.Unbind(namerType(), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	var fromParent scopeNamer
+	if err := parent.Resolve(&fromParent); err != nil {
+		t.Fatal(err)
+	}
+	if fromParent.Name() != "parent" {
+		t.Errorf("expected parent's binding to survive the child's Unbind, got %q", fromParent.Name())
+	}
+
+	// With its own binding gone, the child should now fall back to parent.
+	var fromChild scopeNamer
+	if err := child.Resolve(&fromChild); err != nil {
+		t.Fatal(err)
+	}
+	if fromChild.Name() != "parent" {
+		t.Errorf("expected the child to fall back to the parent after Unbind, got %q", fromChild.Name())
+	}
+}
+
+type scopeFillTarget struct {
+	N scopeNamer `container:"type"`
+}
+
+func TestScopeFillHonorsChain(t *testing.T) {
+	parent := New()
+	if err := parent.Singleton(func() scopeNamer { return scopeStaticNamer("root") }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+
+	var target scopeFillTarget
+	if err := child.Fill(&target); err != nil {
+		t.Fatal(err)
+	}
+	if target.N.Name() != "root" {
+		t.Errorf("expected Fill on a child to fall back to the parent binding, got %q", target.N.Name())
+	}
+}
+
+type scopeDeepFillOuter struct {
+	Inner scopeFillTarget
+}
+
+func TestScopeDeepFillHonorsChain(t *testing.T) {
+	parent := New()
+	if err := parent.Singleton(func() scopeNamer { return scopeStaticNamer("root") }); err != nil {
+		t.Fatal(err)
+	}
+
+	child := parent.Scope()
+
+	var target scopeDeepFillOuter
+	if err := child.DeepFill(&target); err != nil {
+		t.Fatal(err)
+	}
+	if target.Inner.N.Name() != "root" {
+		t.Errorf("expected DeepFill on a child to fall back to the parent binding, got %q", target.Inner.N.Name())
+	}
+}